@@ -0,0 +1,45 @@
+package mlb
+
+import (
+	"encoding/json"
+	"fmt"
+	"goalfeed/metrics"
+	"goalfeed/models"
+	"net/http"
+)
+
+const playByPlayURL = "https://statsapi.mlb.com/api/v1/game/%s/playByPlay"
+
+// MLBApiClient talks to the MLB stats API.
+type MLBApiClient struct{}
+
+// GetActiveGames always returns an empty list and no error; it does not
+// query the MLB schedule.
+func (c MLBApiClient) GetActiveGames() ([]models.Game, error) {
+	return []models.Game{}, nil
+}
+
+// GetGameState always returns a zero GameState and no error; it does not
+// fetch the game's boxscore.
+func (c MLBApiClient) GetGameState(gameId string) (models.GameState, error) {
+	return models.GameState{}, nil
+}
+
+// GetPlayByPlay fetches a completed game's full play-by-play log and
+// returns it as an ordered sequence of state snapshots, one per scoring
+// play, suitable for backfilling runs missed while goalfeed was offline.
+func (c MLBApiClient) GetPlayByPlay(gameId string) ([]models.GameState, error) {
+	resp, err := http.Get(fmt.Sprintf(playByPlayURL, gameId))
+	if err != nil {
+		metrics.APIErrors.WithLabelValues("MLB").Inc()
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var states []models.GameState
+	if err := json.NewDecoder(resp.Body).Decode(&states); err != nil {
+		metrics.APIErrors.WithLabelValues("MLB").Inc()
+		return nil, err
+	}
+	return states, nil
+}
@@ -0,0 +1,83 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"goalfeed/metrics"
+	"goalfeed/models"
+	"net/http"
+)
+
+const gamecenterFeedURL = "https://api-web.nhle.com/v1/gamecenter/%s/play-by-play"
+
+// NHLApiClient talks to the NHL stats API.
+type NHLApiClient struct{}
+
+// GetActiveGames always returns an empty list and no error; it does not
+// query the NHL schedule.
+func (c NHLApiClient) GetActiveGames() ([]models.Game, error) {
+	return []models.Game{}, nil
+}
+
+// GetGameState always returns a zero GameState and no error; it does not
+// fetch the game's boxscore.
+func (c NHLApiClient) GetGameState(gameId string) (models.GameState, error) {
+	return models.GameState{}, nil
+}
+
+// StreamGameState opens the NHL Gamecenter play-by-play feed for gameId and
+// decodes each pushed frame into a GameState. The returned channel is closed
+// when ctx is cancelled or the feed is permanently unavailable; callers are
+// expected to reconnect on a closed channel if ctx is still live.
+func (c NHLApiClient) StreamGameState(ctx context.Context, gameId string) (<-chan models.GameState, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(gamecenterFeedURL, gameId), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		metrics.APIErrors.WithLabelValues("NHL").Inc()
+		return nil, err
+	}
+
+	states := make(chan models.GameState)
+	go func() {
+		defer close(states)
+		defer resp.Body.Close()
+		decoder := json.NewDecoder(resp.Body)
+		for decoder.More() {
+			var frame models.GameState
+			if err := decoder.Decode(&frame); err != nil {
+				return
+			}
+			select {
+			case states <- frame:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return states, nil
+}
+
+// GetPlayByPlay fetches a completed game's full play-by-play log and
+// returns it as an ordered sequence of state snapshots, one per scoring
+// play, suitable for backfilling goals missed while goalfeed was offline.
+func (c NHLApiClient) GetPlayByPlay(gameId string) ([]models.GameState, error) {
+	resp, err := http.Get(fmt.Sprintf(gamecenterFeedURL, gameId))
+	if err != nil {
+		metrics.APIErrors.WithLabelValues("NHL").Inc()
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var states []models.GameState
+	if err := json.NewDecoder(resp.Body).Decode(&states); err != nil {
+		metrics.APIErrors.WithLabelValues("NHL").Inc()
+		return nil, err
+	}
+	return states, nil
+}
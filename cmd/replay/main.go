@@ -0,0 +1,60 @@
+// Command replay backfills goals for a single completed game (or lets
+// operators dry-run what a replay would emit) by replaying its full
+// play-by-play log through the same path live goals take.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	mlbClients "goalfeed/clients/leagues/mlb"
+	nhlClients "goalfeed/clients/leagues/nhl"
+	"goalfeed/services/events"
+	"goalfeed/services/leagues"
+	"goalfeed/services/leagues/mlb"
+	"goalfeed/services/leagues/nhl"
+	"goalfeed/services/replay"
+	"goalfeed/targets"
+	"goalfeed/targets/database"
+	"goalfeed/targets/pusher"
+	"goalfeed/utils"
+	"os"
+	"strings"
+)
+
+var logger = utils.GetLogger()
+
+func leagueServices() map[string]leagues.ILeagueService {
+	return map[string]leagues.ILeagueService{
+		"NHL": nhl.NHLService{Client: nhlClients.NHLApiClient{}},
+		"MLB": mlb.MLBService{Client: mlbClients.MLBApiClient{}},
+	}
+}
+
+func main() {
+	league := flag.String("league", "", "league to replay (NHL, MLB)")
+	gameId := flag.String("game", "", "league-specific game id to replay")
+	dryRun := flag.Bool("dry-run", false, "log goals instead of sending them to pusher/database")
+	flag.Parse()
+
+	if *league == "" || *gameId == "" {
+		fmt.Fprintln(os.Stderr, "usage: replay -league NHL -game <id> [-dry-run]")
+		os.Exit(1)
+	}
+
+	service, ok := leagueServices()[strings.ToUpper(*league)]
+	if !ok {
+		logger.Error(fmt.Sprintf("Unknown league %s", *league))
+		os.Exit(1)
+	}
+
+	if !*dryRun {
+		database.InitializeDatabase()
+		events.Configure(targets.NewRegistry(context.Background(), []targets.EventSink{pusher.Sink{}, database.Sink{}}))
+	}
+
+	if err := replay.Game(service, *gameId, *dryRun); err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,9 @@
+package config
+
+import "os"
+
+// GetString returns the value of the named environment variable, or an
+// empty string if it is not set.
+func GetString(key string) string {
+	return os.Getenv(key)
+}
@@ -0,0 +1,32 @@
+// Package health aggregates the checks goalfeed's readiness probe runs:
+// dependency connectivity and per-league poll freshness.
+package health
+
+import (
+	"fmt"
+	"goalfeed/metrics"
+	"goalfeed/targets/database"
+	"goalfeed/targets/redis"
+	"time"
+)
+
+// MaxPollAge is how long a registered league can go without a successful
+// poll before Ready reports it as stale.
+const MaxPollAge = 5 * time.Minute
+
+// Ready returns nil when goalfeed is fit to serve traffic: redis and the
+// database are reachable, and every registered league has polled
+// successfully within MaxPollAge. Otherwise it returns an error describing
+// what's wrong.
+func Ready() error {
+	if err := redis.Ping(); err != nil {
+		return fmt.Errorf("redis unreachable: %w", err)
+	}
+	if err := database.Ping(); err != nil {
+		return fmt.Errorf("database unreachable: %w", err)
+	}
+	if stale := metrics.StalePolls(MaxPollAge); len(stale) > 0 {
+		return fmt.Errorf("no successful poll in %s for: %v", MaxPollAge, stale)
+	}
+	return nil
+}
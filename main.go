@@ -1,29 +1,62 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	mlbClients "goalfeed/clients/leagues/mlb"
 	nhlClients "goalfeed/clients/leagues/nhl"
 	"goalfeed/config"
+	"goalfeed/health"
+	"goalfeed/metrics"
 	"goalfeed/models"
+	"goalfeed/services/events"
+	"goalfeed/services/games"
+	"goalfeed/services/leader"
 	"goalfeed/services/leagues"
 	"goalfeed/services/leagues/mlb"
 	"goalfeed/services/leagues/nhl"
+	"goalfeed/services/replay"
+	"goalfeed/targets"
 	"goalfeed/targets/database"
+	"goalfeed/targets/discord"
+	"goalfeed/targets/homeassistant"
+	"goalfeed/targets/mqtt"
 	"goalfeed/targets/pusher"
 	"goalfeed/targets/redis"
+	"goalfeed/targets/webhook"
 	"goalfeed/utils"
+	"net/http"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bugsnag/bugsnag-go/v2"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 var (
 	leagueServices = map[int]leagues.ILeagueService{}
-	needRefresh    = false
 	logger         = utils.GetLogger()
+
+	// gameSupervisor owns one polling goroutine per active game, reconciled
+	// against redis.GetActiveGameKeys() by syncSupervisedGames.
+	gameSupervisor *games.Supervisor
+
+	// refreshSignal wakes runGameSyncLoop to re-check leagues for new games
+	// immediately, instead of waiting for the next scheduled check.
+	refreshSignal = make(chan struct{}, 1)
+
+	// isLeader reports whether this replica currently holds the leader lock
+	// (or leader election is disabled). Only the leader polls games.
+	isLeader atomic.Bool
+
+	// activeStreams tracks games currently fed by a live subscription
+	// rather than polling, keyed by game key, so syncSupervisedGames can
+	// tear them down once they're no longer active.
+	activeStreams   = map[string]context.CancelFunc{}
+	activeStreamsMu sync.Mutex
 )
 
 func init() {
@@ -37,9 +70,75 @@ func init() {
 
 func main() {
 	initialize()
+	startMetricsServer()
+	startLeaderElection(context.Background())
 	runTickers()
 }
 
+// startLeaderElection makes this replica the active poller. By default
+// (LEADER_ELECTION_ENABLED unset) there's no coordination to do and this
+// replica always leads, preserving single-instance behavior. Set it to
+// "true" to run multiple replicas against a shared redis for availability,
+// with only the Redis-elected leader actually polling games.
+func startLeaderElection(ctx context.Context) {
+	if config.GetString("LEADER_ELECTION_ENABLED") != "true" {
+		onAcquireLeadership()
+		return
+	}
+
+	elector := leader.NewElector(redis.Client())
+	go elector.Run(ctx, onAcquireLeadership, func() {
+		logger.Info("Lost leader lock, pausing game polling")
+		isLeader.Store(false)
+		gameSupervisor.Stop()
+		stopAllStreams()
+	})
+}
+
+// onAcquireLeadership marks this replica as leader and runs the work only
+// the leader should do. Replaying games missed while offline belongs here,
+// not in initialize(): with leader election enabled every replica runs
+// initialize() on startup, and replaying on all of them would fire
+// duplicate goal notifications and race each other deleting the same
+// active game keys.
+func onAcquireLeadership() {
+	logger.Info("Acquired leader lock, resuming game polling")
+	isLeader.Store(true)
+	logger.Info("Replaying games missed while offline")
+	replayMissedGames()
+	requestRefresh()
+}
+
+// startMetricsServer serves Prometheus metrics and health/readiness probes
+// so goalfeed can run under an orchestrator that autorestarts on failure.
+func startMetricsServer() {
+	addr := config.GetString("METRICS_ADDR")
+	if addr == "" {
+		addr = ":9090"
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if err := health.Ready(); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	go func() {
+		logger.Info(fmt.Sprintf("Serving metrics and health probes on %s", addr))
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+}
+
 func runTickers() {
 	var wg sync.WaitGroup
 	tickers := []struct {
@@ -47,14 +146,7 @@ func runTickers() {
 		task     func()
 	}{
 		{1 * time.Minute, checkLeaguesForActiveGames},
-		{1 * time.Second, watchActiveGames},
 		{1 * time.Minute, sendTestGoal},
-		{5 * time.Second, func() {
-			if needRefresh {
-				checkLeaguesForActiveGames()
-				needRefresh = false
-			}
-		}},
 	}
 
 	for _, t := range tickers {
@@ -68,21 +160,164 @@ func runTickers() {
 		}(t.duration, t.task)
 	}
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runGameSyncLoop(context.Background())
+	}()
+
 	wg.Wait()
 }
 
+// runGameSyncLoop periodically reconciles gameSupervisor against redis's
+// active game set, and immediately re-checks leagues for new games whenever
+// requestRefresh is called (e.g. because a game key vanished unexpectedly,
+// or this replica just became leader) rather than waiting on a ticker.
+func runGameSyncLoop(ctx context.Context) {
+	resync := time.NewTicker(1 * time.Second)
+	defer resync.Stop()
+	for {
+		select {
+		case <-resync.C:
+			syncSupervisedGames()
+		case <-refreshSignal:
+			checkLeaguesForActiveGames()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// requestRefresh asks runGameSyncLoop to re-check leagues for new games as
+// soon as possible. It never blocks: a pending request is enough, so a
+// second one before it's serviced is dropped.
+func requestRefresh() {
+	select {
+	case refreshSignal <- struct{}{}:
+	default:
+	}
+}
+
 func initialize() {
 	logger.Info("Init DB")
 	database.InitializeDatabase()
 	logger.Info("Puck Drop! Initializing Goalfeed Process")
 
-	leagueServices[models.LeagueIdNHL] = nhl.NHLService{Client: nhlClients.NHLApiClient{}}
-	leagueServices[models.LeagueIdMLB] = mlb.MLBService{Client: mlbClients.MLBApiClient{}}
+	leagueServices = buildLeagueServices()
+	for _, service := range leagueServices {
+		metrics.RegisterLeague(service.GetLeagueName())
+	}
+	gameSupervisor = games.NewSupervisor(1*time.Second, checkGame)
+
+	events.Configure(targets.NewRegistry(context.Background(), buildSinks()))
 
 	logger.Info("Initializing Active Games")
 	checkLeaguesForActiveGames()
 }
 
+// buildLeagueServices resolves the comma-separated ENABLED_LEAGUES env var
+// (e.g. "NHL,MLB,NBA") into the league services checkLeaguesForActiveGames
+// and gameSupervisor poll, defaulting to NHL+MLB when unset. NBA, NFL and
+// Soccer are wired up generically through leagues.SportsFeedAdapter rather
+// than a hand-written client package.
+func buildLeagueServices() map[int]leagues.ILeagueService {
+	names := config.GetString("ENABLED_LEAGUES")
+	if names == "" {
+		names = "NHL,MLB"
+	}
+
+	services := map[int]leagues.ILeagueService{}
+	for _, name := range strings.Split(names, ",") {
+		switch strings.ToUpper(strings.TrimSpace(name)) {
+		case "NHL":
+			services[models.LeagueIdNHL] = nhl.NHLService{Client: nhlClients.NHLApiClient{}}
+		case "MLB":
+			services[models.LeagueIdMLB] = mlb.MLBService{Client: mlbClients.MLBApiClient{}}
+		case "NBA":
+			services[models.LeagueIdNBA] = leagues.SportsFeedAdapter{Config: leagues.NBAAdapterConfig}
+		case "NFL":
+			services[models.LeagueIdNFL] = leagues.SportsFeedAdapter{Config: leagues.NFLAdapterConfig}
+		case "SOCCER":
+			services[models.LeagueIdSoccer] = leagues.SportsFeedAdapter{Config: leagues.SoccerAdapterConfig}
+		}
+	}
+	return services
+}
+
+// buildSinks resolves the comma-separated SINKS env var (e.g.
+// "pusher,webhook,mqtt") into the EventSink implementations goals should be
+// delivered to, defaulting to goalfeed's original pusher+postgres targets
+// when SINKS is unset. A sink that fails to configure (e.g. a missing env
+// var) is logged and skipped rather than aborting startup.
+func buildSinks() []targets.EventSink {
+	names := config.GetString("SINKS")
+	if names == "" {
+		names = "pusher,postgres"
+	}
+
+	var sinks []targets.EventSink
+	for _, name := range strings.Split(names, ",") {
+		switch strings.TrimSpace(name) {
+		case "pusher":
+			sinks = append(sinks, pusher.Sink{})
+		case "postgres":
+			sinks = append(sinks, database.Sink{})
+		case "webhook":
+			if sink, err := webhook.New(); err != nil {
+				logger.Error(err.Error())
+			} else {
+				sinks = append(sinks, sink)
+			}
+		case "discord":
+			if sink, err := discord.New(); err != nil {
+				logger.Error(err.Error())
+			} else {
+				sinks = append(sinks, sink)
+			}
+		case "mqtt":
+			if sink, err := mqtt.New(); err != nil {
+				logger.Error(err.Error())
+			} else {
+				sinks = append(sinks, sink)
+			}
+		case "homeassistant":
+			if sink, err := homeassistant.New(); err != nil {
+				logger.Error(err.Error())
+			} else {
+				sinks = append(sinks, sink)
+			}
+		}
+	}
+	return sinks
+}
+
+// replayMissedGames backfills goals for any game that ended while goalfeed
+// was offline, i.e. it's still in redis as active but never had
+// DeleteActiveGame called on it, then drops it from the active set.
+func replayMissedGames() {
+	for _, gameKey := range redis.GetActiveGameKeys() {
+		game, err := redis.GetGameByGameKey(gameKey)
+		if err != nil {
+			continue
+		}
+		if game.CurrentState.Status != models.StatusEnded {
+			continue
+		}
+
+		service, ok := leagueServices[int(game.LeagueId)]
+		if !ok {
+			logger.Error(fmt.Sprintf("[%s] Skipping replay, league %d is no longer enabled", gameKey, game.LeagueId))
+			redis.DeleteActiveGame(game)
+			continue
+		}
+		logger.Info(fmt.Sprintf("[%s] Found game that ended while offline, replaying missed goals", gameKey))
+		if err := replay.Game(service, game.GameId, false); err != nil {
+			logger.Error(err.Error())
+		}
+		redis.DeleteActiveGame(game)
+	}
+}
+
 func checkLeaguesForActiveGames() {
 	logger.Info("Updating Active Games")
 	for _, service := range leagueServices {
@@ -92,9 +327,19 @@ func checkLeaguesForActiveGames() {
 
 func checkForNewActiveGames(service leagues.ILeagueService) {
 	logger.Info(fmt.Sprintf("Checking for active %s games", service.GetLeagueName()))
-	gamesChan := make(chan []models.Game)
-	go service.GetActiveGames(gamesChan)
-	for _, game := range <-gamesChan {
+	resultChan := make(chan models.ActiveGamesResult)
+	go service.GetActiveGames(resultChan)
+	result := <-resultChan
+	if result.Err != nil {
+		logger.Error(result.Err.Error())
+		return
+	}
+	// A genuinely successful schedule fetch counts as a poll even when it
+	// finds nothing in progress (e.g. offseason), so such a league isn't
+	// reported stale by health.Ready for merely having no active games.
+	metrics.RecordPollSuccess(service.GetLeagueName())
+
+	for _, game := range result.Games {
 		if !gameIsMonitored(game) {
 			logger.Info(fmt.Sprintf("Adding %s game (%s @ %s) to active monitored games", service.GetLeagueName(), game.CurrentState.Away.Team.TeamCode, game.CurrentState.Home.Team.TeamCode))
 			redis.SetGame(game)
@@ -112,9 +357,52 @@ func gameIsMonitored(game models.Game) bool {
 	return false
 }
 
-func watchActiveGames() {
-	for _, gameKey := range redis.GetActiveGameKeys() {
-		go checkGame(gameKey)
+// syncSupervisedGames reconciles gameSupervisor against the games redis
+// currently lists as active. Games already being fed by a live subscription
+// still get a supervisor slot: checkGame is cheap to call redundantly for
+// them (it just confirms the subscription is still live), and it means a
+// stream that drops keeps getting picked back up on the very next interval
+// instead of waiting for a separate polling path.
+func syncSupervisedGames() {
+	activeKeys := redis.GetActiveGameKeys()
+	metrics.ActiveGames.Set(float64(len(activeKeys)))
+
+	stillActive := make(map[string]bool, len(activeKeys))
+	for _, gameKey := range activeKeys {
+		stillActive[gameKey] = true
+	}
+	stopOrphanedStreams(stillActive)
+
+	if !isLeader.Load() {
+		gameSupervisor.Stop()
+		stopAllStreams()
+		return
+	}
+	gameSupervisor.Reconcile(activeKeys)
+}
+
+// stopOrphanedStreams cancels any subscription for a game that redis no
+// longer lists as active, e.g. because it was dropped while offline.
+func stopOrphanedStreams(stillActive map[string]bool) {
+	activeStreamsMu.Lock()
+	defer activeStreamsMu.Unlock()
+	for gameKey, cancel := range activeStreams {
+		if !stillActive[gameKey] {
+			cancel()
+			delete(activeStreams, gameKey)
+		}
+	}
+}
+
+// stopAllStreams cancels every live subscription, e.g. because this
+// replica just lost leadership and must stop mutating redis and firing
+// events for games the new leader now owns.
+func stopAllStreams() {
+	activeStreamsMu.Lock()
+	defer activeStreamsMu.Unlock()
+	for gameKey, cancel := range activeStreams {
+		cancel()
+		delete(activeStreams, gameKey)
 	}
 }
 
@@ -124,19 +412,29 @@ func checkGame(gameKey string) {
 		logger.Error(err.Error())
 		logger.Error(fmt.Sprintf("[%s] Game not found, skipping", gameKey))
 		redis.DeleteActiveGameKey(gameKey)
-		needRefresh = true
+		requestRefresh()
 		return
 	}
 
 	service := leagueServices[int(game.LeagueId)]
+
+	if startGameStream(gameKey, game, service) {
+		return
+	}
+
 	logger.Info(fmt.Sprintf("[%s - %s @ %s] Checking", service.GetLeagueName(), game.CurrentState.Away.Team.TeamCode, game.CurrentState.Home.Team.TeamCode))
 	game.IsFetching = true
 	redis.SetGame(game)
 
 	updateChan := make(chan models.GameUpdate)
 	eventChan := make(chan []models.Event)
+	fetchStart := time.Now()
 	go service.GetGameUpdate(game, updateChan)
 	update := <-updateChan
+	metrics.UpdateFetchDuration.WithLabelValues(service.GetLeagueName()).Observe(time.Since(fetchStart).Seconds())
+	if update.Err == nil {
+		metrics.RecordPollSuccess(service.GetLeagueName())
+	}
 	go service.GetEvents(update, eventChan)
 	go fireGoalEvents(eventChan, game)
 	game.CurrentState = update.NewState
@@ -144,33 +442,85 @@ func checkGame(gameKey string) {
 	if game.CurrentState.Status == models.StatusEnded {
 		logger.Info(fmt.Sprintf("[%s - %s @ %s] Game has ended", service.GetLeagueName(), game.CurrentState.Away.Team.TeamCode, game.CurrentState.Home.Team.TeamCode))
 		redis.DeleteActiveGame(game)
+		gameSupervisor.StopGame(gameKey)
 	} else {
 		game.IsFetching = false
 		redis.SetGame(game)
 	}
 }
 
-func fireGoalEvents(events chan []models.Event, game models.Game) {
-	for _, event := range <-events {
-		logger.Info(fmt.Sprintf("Goal %s", event.TeamCode))
-		go pusher.SendEvent(event)
-		var scoringTeam models.Team
-		if event.TeamCode == game.CurrentState.Home.Team.TeamCode {
-			scoringTeam = game.CurrentState.Home.Team
-		} else {
-			scoringTeam = game.CurrentState.Away.Team
+// startGameStream tries to hand gameKey off to a live subscription. It
+// returns true if the game is (now) being streamed, meaning the caller
+// should skip its own polling pass. On subscribe failure it returns false
+// so the caller falls back to polling as before.
+func startGameStream(gameKey string, game models.Game, service leagues.ILeagueService) bool {
+	activeStreamsMu.Lock()
+	if _, ok := activeStreams[gameKey]; ok {
+		activeStreamsMu.Unlock()
+		return true
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := service.SubscribeGameUpdates(game, ctx)
+	if err != nil {
+		cancel()
+		activeStreamsMu.Unlock()
+		logger.Info(fmt.Sprintf("[%s] Subscription unavailable (%s), falling back to polling", gameKey, err.Error()))
+		return false
+	}
+
+	activeStreams[gameKey] = cancel
+	activeStreamsMu.Unlock()
+
+	logger.Info(fmt.Sprintf("[%s - %s @ %s] Subscribed to live updates", service.GetLeagueName(), game.CurrentState.Away.Team.TeamCode, game.CurrentState.Home.Team.TeamCode))
+	go streamGame(gameKey, game, service, cancel, updates)
+	return true
+}
+
+// streamGame consumes a subscription until the game ends or the channel is
+// closed (subscription gave up after ctx was cancelled), cleaning up
+// activeStreams either way.
+func streamGame(gameKey string, game models.Game, service leagues.ILeagueService, cancel context.CancelFunc, updates <-chan models.GameUpdate) {
+	defer func() {
+		activeStreamsMu.Lock()
+		delete(activeStreams, gameKey)
+		activeStreamsMu.Unlock()
+		cancel()
+	}()
+
+	for update := range updates {
+		if update.Err == nil {
+			metrics.RecordPollSuccess(service.GetLeagueName())
+		}
+		eventChan := make(chan []models.Event)
+		go service.GetEvents(update, eventChan)
+		go fireGoalEvents(eventChan, game)
+		game.CurrentState = update.NewState
+		redis.SetGame(game)
+
+		if game.CurrentState.Status == models.StatusEnded {
+			logger.Info(fmt.Sprintf("[%s - %s @ %s] Game has ended, stream closing", service.GetLeagueName(), game.CurrentState.Away.Team.TeamCode, game.CurrentState.Home.Team.TeamCode))
+			redis.DeleteActiveGame(game)
+			gameSupervisor.StopGame(gameKey)
+			return
 		}
-		go database.InsertGoal(scoringTeam)
 	}
 }
 
+func fireGoalEvents(eventChan chan []models.Event, game models.Game) {
+	events.Fire(<-eventChan, game)
+}
+
+// sendTestGoal fires a synthetic goal through the same sink registry real
+// goals use, so operators can verify every configured sink (not just
+// pusher) end-to-end.
 func sendTestGoal() {
 	logger.Info("Sending test goal")
-	go pusher.SendEvent(models.Event{
+	events.Fire([]models.Event{{
 		TeamCode:   "TEST",
 		TeamName:   "TEST",
 		LeagueId:   0,
 		LeagueName: "TEST",
 		TeamHash:   "TESTTEST",
-	})
+	}}, models.Game{})
 }
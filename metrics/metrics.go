@@ -0,0 +1,82 @@
+// Package metrics holds goalfeed's Prometheus instruments and the poll
+// freshness tracking the readiness probe relies on.
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// GoalsFired counts goals fired, by league and team. It is incremented
+	// before sink delivery, so dropped or failed deliveries are counted too.
+	GoalsFired = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "goalfeed_goals_fired_total",
+		Help: "Goals fired to sinks, labelled by league and team.",
+	}, []string{"league", "team"})
+
+	// UpdateFetchDuration times a single game update fetch, by league.
+	UpdateFetchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "goalfeed_update_fetch_duration_seconds",
+		Help: "Time spent fetching a single game update, labelled by league.",
+	}, []string{"league"})
+
+	// ActiveGames tracks how many games are currently being monitored.
+	ActiveGames = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "goalfeed_active_games",
+		Help: "Number of games currently being monitored.",
+	})
+
+	// APIErrors counts league API failures, by league.
+	APIErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "goalfeed_api_errors_total",
+		Help: "League API errors, labelled by league.",
+	}, []string{"league"})
+
+	// SinkLatency times event delivery to a single sink, by sink name.
+	SinkLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "goalfeed_sink_latency_seconds",
+		Help: "Time spent delivering an event to a sink, labelled by sink.",
+	}, []string{"sink"})
+)
+
+var (
+	lastPollMu sync.Mutex
+	lastPoll   = map[string]time.Time{}
+)
+
+// RegisterLeague marks league as one the readiness probe should watch for
+// staleness, defaulting its last-successful-poll to the zero time so a
+// league that never manages a single poll is immediately reported stale.
+func RegisterLeague(league string) {
+	lastPollMu.Lock()
+	defer lastPollMu.Unlock()
+	if _, ok := lastPoll[league]; !ok {
+		lastPoll[league] = time.Time{}
+	}
+}
+
+// RecordPollSuccess marks league as having successfully polled just now.
+func RecordPollSuccess(league string) {
+	lastPollMu.Lock()
+	defer lastPollMu.Unlock()
+	lastPoll[league] = time.Now()
+}
+
+// StalePolls returns every registered league whose last successful poll is
+// older than maxAge.
+func StalePolls(maxAge time.Duration) []string {
+	lastPollMu.Lock()
+	defer lastPollMu.Unlock()
+
+	var stale []string
+	for league, at := range lastPoll {
+		if time.Since(at) > maxAge {
+			stale = append(stale, league)
+		}
+	}
+	return stale
+}
@@ -0,0 +1,10 @@
+package models
+
+// Event represents a single scoring event detected for a game.
+type Event struct {
+	TeamCode   string
+	TeamName   string
+	LeagueId   int
+	LeagueName string
+	TeamHash   string
+}
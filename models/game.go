@@ -0,0 +1,57 @@
+package models
+
+import "fmt"
+
+// Status represents the lifecycle state of a game.
+type Status int
+
+const (
+	StatusPreGame Status = iota
+	StatusActive
+	StatusEnded
+)
+
+// Side holds the team and score for one side of a game.
+type Side struct {
+	Team  Team
+	Score int
+}
+
+// GameState is a point-in-time snapshot of a game.
+type GameState struct {
+	Home   Side
+	Away   Side
+	Status Status
+}
+
+// Game tracks a single league game being monitored for goal events.
+type Game struct {
+	GameId       string
+	LeagueId     int
+	CurrentState GameState
+	IsFetching   bool
+}
+
+// GetGameKey returns the identifier used to key this game in redis.
+func (g Game) GetGameKey() string {
+	return fmt.Sprintf("%d-%s", g.LeagueId, g.GameId)
+}
+
+// GameUpdate carries a freshly fetched state alongside the state it
+// replaces. Err is set when the fetch itself failed, in which case
+// NewState is just a copy of OldState and callers should not treat the
+// update as a genuine poll success.
+type GameUpdate struct {
+	OldState GameState
+	NewState GameState
+	Err      error
+}
+
+// ActiveGamesResult carries the games an active-games fetch found,
+// alongside whether the fetch itself succeeded. Games is empty (not an
+// error) whenever a league simply has nothing in progress right now, e.g.
+// offseason.
+type ActiveGamesResult struct {
+	Games []Game
+	Err   error
+}
@@ -0,0 +1,10 @@
+package models
+
+// League IDs uniquely identify a supported sports league.
+const (
+	LeagueIdNHL = iota + 1
+	LeagueIdMLB
+	LeagueIdNBA
+	LeagueIdNFL
+	LeagueIdSoccer
+)
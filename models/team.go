@@ -0,0 +1,7 @@
+package models
+
+// Team represents a single team within a game.
+type Team struct {
+	TeamCode string
+	TeamName string
+}
@@ -0,0 +1,49 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"goalfeed/metrics"
+	"goalfeed/models"
+	"goalfeed/targets"
+	"goalfeed/utils"
+)
+
+var (
+	logger   = utils.GetLogger()
+	registry *targets.Registry
+)
+
+// Configure sets the sink registry Fire delivers events to. It must be
+// called once during startup, before any goals are fired; until then Fire
+// only logs and counts events without delivering them to any sink.
+func Configure(r *targets.Registry) {
+	registry = r
+}
+
+// Fire delivers each event to the configured sink registry. This is the
+// single path both live polling/streaming and replay use, so a goal is
+// recorded the same way regardless of how it was detected.
+func Fire(evts []models.Event, game models.Game) {
+	for _, event := range evts {
+		logger.Info(fmt.Sprintf("Goal %s", event.TeamCode))
+		metrics.GoalsFired.WithLabelValues(event.LeagueName, event.TeamCode).Inc()
+		if registry != nil {
+			registry.Emit(event, game)
+		}
+	}
+}
+
+// FireSync behaves like Fire but waits for every sink to finish delivering
+// each event before returning. Short-lived processes (the replay CLI) need
+// this: they exit right after the call and would otherwise race their own
+// process exit against Fire's async per-sink goroutines.
+func FireSync(evts []models.Event, game models.Game) {
+	for _, event := range evts {
+		logger.Info(fmt.Sprintf("Goal %s", event.TeamCode))
+		metrics.GoalsFired.WithLabelValues(event.LeagueName, event.TeamCode).Inc()
+		if registry != nil {
+			registry.EmitSync(context.Background(), event, game)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Package games supervises the goroutines that poll individual monitored
+// games, replacing a global ticker that fired a fresh goroutine per game on
+// every tick regardless of whether that game's previous check had finished.
+package games
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Handler is invoked on each tick for a game key the Supervisor owns.
+type Handler func(gameKey string)
+
+// Supervisor owns one goroutine per monitored game. A game key is started
+// exactly once, on the first Reconcile call that includes it, and stopped
+// exactly once, either explicitly or on a Reconcile call that omits it -
+// so games can't be silently duplicated or leaked across resyncs.
+type Supervisor struct {
+	handler  Handler
+	interval time.Duration
+
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+// NewSupervisor creates a Supervisor that calls handler for each owned game
+// key at most once per interval, bounding how fast any single game can be
+// re-polled even if it flaps in and out of the active set.
+func NewSupervisor(interval time.Duration, handler Handler) *Supervisor {
+	return &Supervisor{
+		handler:  handler,
+		interval: interval,
+		cancels:  map[string]context.CancelFunc{},
+	}
+}
+
+// Reconcile starts a goroutine for any key in keys the Supervisor doesn't
+// already own, and stops any goroutine it owns whose key is no longer in
+// keys.
+func (s *Supervisor) Reconcile(keys []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wanted := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		wanted[key] = true
+		if _, ok := s.cancels[key]; ok {
+			continue
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		s.cancels[key] = cancel
+		go s.run(ctx, key)
+	}
+
+	for key, cancel := range s.cancels {
+		if !wanted[key] {
+			cancel()
+			delete(s.cancels, key)
+		}
+	}
+}
+
+// StopGame stops gameKey's goroutine immediately, without waiting for the
+// next Reconcile, e.g. when its own handler detects the game has ended.
+func (s *Supervisor) StopGame(gameKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cancel, ok := s.cancels[gameKey]; ok {
+		cancel()
+		delete(s.cancels, gameKey)
+	}
+}
+
+// Stop stops every game's goroutine, e.g. when this replica loses (or never
+// held) leadership and should not be polling anything.
+func (s *Supervisor) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, cancel := range s.cancels {
+		cancel()
+		delete(s.cancels, key)
+	}
+}
+
+func (s *Supervisor) run(ctx context.Context, gameKey string) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if ctx.Err() != nil {
+				return
+			}
+			s.handler(gameKey)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
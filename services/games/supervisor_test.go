@@ -0,0 +1,122 @@
+package games
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSupervisorReconcileStartsAndStopsKeys(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	s := NewSupervisor(5*time.Millisecond, func(gameKey string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[gameKey]++
+	})
+
+	s.Reconcile([]string{"a", "b"})
+	time.Sleep(30 * time.Millisecond)
+
+	mu.Lock()
+	if calls["a"] == 0 || calls["b"] == 0 {
+		t.Fatalf("expected both keys to have been polled, got %v", calls)
+	}
+	mu.Unlock()
+
+	// Dropping "b" from Reconcile should stop its goroutine.
+	s.Reconcile([]string{"a"})
+	time.Sleep(10 * time.Millisecond)
+
+	mu.Lock()
+	bAfterStop := calls["b"]
+	mu.Unlock()
+	time.Sleep(30 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls["b"] != bAfterStop {
+		t.Errorf("key %q kept being polled after Reconcile dropped it: %d -> %d", "b", bAfterStop, calls["b"])
+	}
+	if calls["a"] == 0 {
+		t.Error("key \"a\" should still be polled")
+	}
+}
+
+func TestSupervisorReconcileIsIdempotentPerKey(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	s := NewSupervisor(5*time.Millisecond, func(gameKey string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+	})
+
+	// Calling Reconcile repeatedly with the same key must not start a
+	// second goroutine for it.
+	for i := 0; i < 5; i++ {
+		s.Reconcile([]string{"a"})
+	}
+	time.Sleep(25 * time.Millisecond)
+	s.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	// A single 5ms-interval goroutine over 25ms fires at most ~5 times;
+	// two goroutines racing would roughly double that.
+	if calls > 8 {
+		t.Errorf("got %d calls, suspiciously high for a single goroutine - key may have been started twice", calls)
+	}
+}
+
+func TestSupervisorStopGame(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	s := NewSupervisor(5*time.Millisecond, func(gameKey string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[gameKey]++
+	})
+
+	s.Reconcile([]string{"a"})
+	time.Sleep(15 * time.Millisecond)
+
+	s.StopGame("a")
+	time.Sleep(5 * time.Millisecond)
+
+	mu.Lock()
+	callsAfterStop := calls["a"]
+	mu.Unlock()
+	time.Sleep(25 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls["a"] != callsAfterStop {
+		t.Errorf("StopGame did not stop polling: %d -> %d", callsAfterStop, calls["a"])
+	}
+}
+
+func TestSupervisorStop(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	s := NewSupervisor(5*time.Millisecond, func(gameKey string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+	})
+
+	s.Reconcile([]string{"a", "b"})
+	time.Sleep(15 * time.Millisecond)
+	s.Stop()
+
+	mu.Lock()
+	callsAfterStop := calls
+	mu.Unlock()
+	time.Sleep(25 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls != callsAfterStop {
+		t.Errorf("Stop did not stop all goroutines: %d -> %d", callsAfterStop, calls)
+	}
+}
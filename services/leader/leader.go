@@ -0,0 +1,89 @@
+// Package leader provides Redis-backed leader election so multiple goalfeed
+// replicas can run for availability while only one of them actively polls
+// games at a time.
+package leader
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+const (
+	lockKey    = "goalfeed:leader"
+	ttl        = 15 * time.Second
+	renewEvery = 5 * time.Second
+)
+
+// Elector campaigns for a single Redis-wide leader lock, renewing it while
+// held and re-campaigning after losing it or on startup.
+type Elector struct {
+	client *goredis.Client
+	id     string
+}
+
+// NewElector creates an Elector that identifies itself with a value unique
+// to this process, so it can tell its own lock apart from another
+// replica's when renewing.
+func NewElector(client *goredis.Client) *Elector {
+	host, _ := os.Hostname()
+	return &Elector{
+		client: client,
+		id:     fmt.Sprintf("%s:%d", host, os.Getpid()),
+	}
+}
+
+// Run campaigns for leadership until ctx is cancelled, calling onAcquire
+// each time this replica becomes leader and onLose each time it stops being
+// leader (including when ctx is cancelled while it still holds the lock).
+func (e *Elector) Run(ctx context.Context, onAcquire func(), onLose func()) {
+	ticker := time.NewTicker(renewEvery)
+	defer ticker.Stop()
+
+	leading := false
+	for {
+		acquired, err := e.tryAcquire(ctx)
+		if err == nil && acquired && !leading {
+			leading = true
+			onAcquire()
+		} else if (err != nil || !acquired) && leading {
+			leading = false
+			onLose()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			if leading {
+				onLose()
+			}
+			return
+		}
+	}
+}
+
+// tryAcquire acquires the lock if unheld, or renews it if this Elector
+// already holds it. It reports true only when this Elector holds the lock
+// afterward.
+func (e *Elector) tryAcquire(ctx context.Context) (bool, error) {
+	ok, err := e.client.SetNX(ctx, lockKey, e.id, ttl).Result()
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		return true, nil
+	}
+
+	holder, err := e.client.Get(ctx, lockKey).Result()
+	if err != nil {
+		return false, err
+	}
+	if holder != e.id {
+		return false, nil
+	}
+
+	return true, e.client.Expire(ctx, lockKey, ttl).Err()
+}
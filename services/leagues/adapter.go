@@ -0,0 +1,247 @@
+package leagues
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goalfeed/config"
+	"goalfeed/metrics"
+	"goalfeed/models"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrAdapterSubscribeUnsupported is returned by SportsFeedAdapter's
+// SubscribeGameUpdates and GetGameReplay: a generically-configured feed
+// only exposes a boxscore snapshot, not a push feed or a full play log.
+var ErrAdapterSubscribeUnsupported = errors.New("leagues: adapter league has no live feed, use polling")
+
+// FieldPath is a dot-separated path into a decoded JSON document, e.g.
+// "liveData.linescore.teams.home.runs".
+type FieldPath string
+
+func (p FieldPath) lookup(doc interface{}) (interface{}, bool) {
+	current := doc
+	for _, key := range strings.Split(string(p), ".") {
+		if idx, err := strconv.Atoi(key); err == nil {
+			arr, ok := current.([]interface{})
+			if !ok || idx < 0 || idx >= len(arr) {
+				return nil, false
+			}
+			current = arr[idx]
+			continue
+		}
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = obj[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func (p FieldPath) lookupString(doc interface{}) string {
+	v, ok := p.lookup(doc)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func (p FieldPath) lookupInt(doc interface{}) int {
+	v, ok := p.lookup(doc)
+	if !ok {
+		return 0
+	}
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	default:
+		return 0
+	}
+}
+
+// AdapterConfig declares how to map a league's stats API onto
+// leagues.ILeagueService without writing a dedicated client package: where
+// to find the schedule and boxscore, and where within those JSON documents
+// the fields goalfeed cares about live.
+type AdapterConfig struct {
+	LeagueId   int
+	LeagueName string
+
+	// ScheduleURL returns today's games; GamesPath locates the array of
+	// in-progress games within it, and GameIdPath locates each one's id.
+	ScheduleURL string
+	GamesPath   FieldPath
+	GameIdPath  FieldPath
+
+	// BoxscoreURLFmt is a fmt string taking a game id, returning the
+	// boxscore document the remaining paths are resolved against.
+	BoxscoreURLFmt string
+
+	HomeCodePath  FieldPath
+	AwayCodePath  FieldPath
+	HomeNamePath  FieldPath
+	AwayNamePath  FieldPath
+	HomeScorePath FieldPath
+	AwayScorePath FieldPath
+
+	// StatusPath resolves to StatusEndedVal once a game is final and to
+	// StatusScheduledVal before it has started; anything else is treated
+	// as in progress.
+	StatusPath         FieldPath
+	StatusEndedVal     string
+	StatusScheduledVal string
+
+	// Headers are sent as-is on every request. Some APIs (e.g.
+	// stats.nba.com) 403 without a browser-like User-Agent/Referer.
+	Headers map[string]string
+
+	// AuthHeader and AuthTokenEnv, if both set, add a
+	// Headers[AuthHeader] = config.GetString(AuthTokenEnv) header to every
+	// request, for APIs gated behind a token that shouldn't be hardcoded
+	// into the adapter config.
+	AuthHeader   string
+	AuthTokenEnv string
+}
+
+// SportsFeedAdapter implements leagues.ILeagueService against any stats API
+// describable by an AdapterConfig, so a new league can be added by data
+// rather than by writing a new client + service package pair.
+type SportsFeedAdapter struct {
+	Config AdapterConfig
+}
+
+// GetLeagueName returns the display name for this league.
+func (a SportsFeedAdapter) GetLeagueName() string {
+	return a.Config.LeagueName
+}
+
+// fetchJSON GETs url with the adapter's configured headers and auth token
+// (if any) and decodes the response body as JSON.
+func (a SportsFeedAdapter) fetchJSON(url string) (interface{}, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range a.Config.Headers {
+		req.Header.Set(key, value)
+	}
+	if a.Config.AuthHeader != "" && a.Config.AuthTokenEnv != "" {
+		req.Header.Set(a.Config.AuthHeader, config.GetString(a.Config.AuthTokenEnv))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var doc interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (a SportsFeedAdapter) stateFromDoc(doc interface{}) models.GameState {
+	state := models.GameState{
+		Home: models.Side{
+			Team:  models.Team{TeamCode: a.Config.HomeCodePath.lookupString(doc), TeamName: a.Config.HomeNamePath.lookupString(doc)},
+			Score: a.Config.HomeScorePath.lookupInt(doc),
+		},
+		Away: models.Side{
+			Team:  models.Team{TeamCode: a.Config.AwayCodePath.lookupString(doc), TeamName: a.Config.AwayNamePath.lookupString(doc)},
+			Score: a.Config.AwayScorePath.lookupInt(doc),
+		},
+	}
+	switch a.Config.StatusPath.lookupString(doc) {
+	case a.Config.StatusEndedVal:
+		state.Status = models.StatusEnded
+	case a.Config.StatusScheduledVal:
+		state.Status = models.StatusPreGame
+	default:
+		state.Status = models.StatusActive
+	}
+	return state
+}
+
+// GetActiveGames fetches the schedule and writes today's in-progress games to resultChan.
+// Scheduled-but-not-started and already-final entries are excluded: only
+// games currently live are worth monitoring.
+func (a SportsFeedAdapter) GetActiveGames(resultChan chan models.ActiveGamesResult) {
+	doc, err := a.fetchJSON(a.Config.ScheduleURL)
+	if err != nil {
+		metrics.APIErrors.WithLabelValues(a.Config.LeagueName).Inc()
+		resultChan <- models.ActiveGamesResult{Err: err}
+		return
+	}
+
+	rawGames, ok := a.Config.GamesPath.lookup(doc)
+	if !ok {
+		resultChan <- models.ActiveGamesResult{}
+		return
+	}
+
+	list, ok := rawGames.([]interface{})
+	if !ok {
+		resultChan <- models.ActiveGamesResult{}
+		return
+	}
+
+	games := make([]models.Game, 0, len(list))
+	for _, entry := range list {
+		gameId := a.Config.GameIdPath.lookupString(entry)
+		if gameId == "" {
+			continue
+		}
+		state := a.stateFromDoc(entry)
+		if state.Status != models.StatusActive {
+			continue
+		}
+		games = append(games, models.Game{
+			GameId:       gameId,
+			LeagueId:     a.Config.LeagueId,
+			CurrentState: state,
+		})
+	}
+	resultChan <- models.ActiveGamesResult{Games: games}
+}
+
+// GetGameUpdate fetches the boxscore for game and writes the resulting update to updateChan.
+func (a SportsFeedAdapter) GetGameUpdate(game models.Game, updateChan chan models.GameUpdate) {
+	doc, err := a.fetchJSON(fmt.Sprintf(a.Config.BoxscoreURLFmt, game.GameId))
+	if err != nil {
+		metrics.APIErrors.WithLabelValues(a.Config.LeagueName).Inc()
+		updateChan <- models.GameUpdate{OldState: game.CurrentState, NewState: game.CurrentState, Err: err}
+		return
+	}
+	updateChan <- models.GameUpdate{OldState: game.CurrentState, NewState: a.stateFromDoc(doc)}
+}
+
+// GetEvents derives goal events from the score delta in update and writes them to eventChan.
+func (a SportsFeedAdapter) GetEvents(update models.GameUpdate, eventChan chan []models.Event) {
+	eventChan <- ScoreDeltaEvents(update, a.Config.LeagueId, a.Config.LeagueName)
+}
+
+// SubscribeGameUpdates always returns ErrAdapterSubscribeUnsupported: a
+// generically-configured league has no known push feed, so callers should
+// fall back to GetGameUpdate polling.
+func (a SportsFeedAdapter) SubscribeGameUpdates(game models.Game, ctx context.Context) (<-chan models.GameUpdate, error) {
+	return nil, ErrAdapterSubscribeUnsupported
+}
+
+// GetGameReplay always returns ErrAdapterSubscribeUnsupported: a
+// generically-configured league only exposes a boxscore snapshot, not a
+// full play log to replay.
+func (a SportsFeedAdapter) GetGameReplay(gameId string) (models.Game, []models.Event, error) {
+	return models.Game{}, nil, ErrAdapterSubscribeUnsupported
+}
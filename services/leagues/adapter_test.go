@@ -0,0 +1,80 @@
+package leagues
+
+import "testing"
+
+func TestFieldPathLookupString(t *testing.T) {
+	doc := map[string]interface{}{
+		"homeTeam": map[string]interface{}{
+			"teamTricode": "BOS",
+		},
+	}
+
+	if got := FieldPath("homeTeam.teamTricode").lookupString(doc); got != "BOS" {
+		t.Errorf("lookupString() = %q, want %q", got, "BOS")
+	}
+	if got := FieldPath("homeTeam.missing").lookupString(doc); got != "" {
+		t.Errorf("lookupString() on missing key = %q, want empty", got)
+	}
+}
+
+func TestFieldPathLookupArrayIndex(t *testing.T) {
+	doc := map[string]interface{}{
+		"competitions": []interface{}{
+			map[string]interface{}{
+				"competitors": []interface{}{
+					map[string]interface{}{"team": map[string]interface{}{"abbreviation": "NE"}},
+					map[string]interface{}{"team": map[string]interface{}{"abbreviation": "NYJ"}},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		path FieldPath
+		want string
+	}{
+		{"competitions.0.competitors.0.team.abbreviation", "NE"},
+		{"competitions.0.competitors.1.team.abbreviation", "NYJ"},
+	}
+	for _, c := range cases {
+		if got := c.path.lookupString(doc); got != c.want {
+			t.Errorf("lookupString(%q) = %q, want %q", c.path, got, c.want)
+		}
+	}
+}
+
+func TestFieldPathLookupArrayIndexOutOfRange(t *testing.T) {
+	doc := map[string]interface{}{
+		"events": []interface{}{
+			map[string]interface{}{"id": "1"},
+		},
+	}
+
+	if _, ok := FieldPath("events.5.id").lookup(doc); ok {
+		t.Error("lookup() with out-of-range index should return ok=false")
+	}
+	if _, ok := FieldPath("events.-1.id").lookup(doc); ok {
+		t.Error("lookup() with negative index should return ok=false")
+	}
+}
+
+func TestFieldPathLookupIntFromFloat64(t *testing.T) {
+	doc := map[string]interface{}{"score": float64(3)}
+	if got := FieldPath("score").lookupInt(doc); got != 3 {
+		t.Errorf("lookupInt() = %d, want 3", got)
+	}
+}
+
+func TestFieldPathLookupIntFromString(t *testing.T) {
+	doc := map[string]interface{}{"score": "7"}
+	if got := FieldPath("score").lookupInt(doc); got != 7 {
+		t.Errorf("lookupInt() = %d, want 7", got)
+	}
+}
+
+func TestFieldPathLookupIntMissing(t *testing.T) {
+	doc := map[string]interface{}{}
+	if got := FieldPath("score").lookupInt(doc); got != 0 {
+		t.Errorf("lookupInt() on missing path = %d, want 0", got)
+	}
+}
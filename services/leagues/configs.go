@@ -0,0 +1,73 @@
+package leagues
+
+import "goalfeed/models"
+
+// NBAAdapterConfig drives a SportsFeedAdapter off stats.nba.com's public
+// scoreboard and boxscore endpoints. stats.nba.com 403s any request that
+// doesn't look like it came from a browser tab on nba.com, hence Headers.
+var NBAAdapterConfig = AdapterConfig{
+	LeagueId:           models.LeagueIdNBA,
+	LeagueName:         "NBA",
+	ScheduleURL:        "https://stats.nba.com/stats/scoreboardv2",
+	GamesPath:          "scoreboard.games",
+	GameIdPath:         "gameId",
+	BoxscoreURLFmt:     "https://stats.nba.com/stats/boxscoretraditionalv2?GameID=%s",
+	HomeCodePath:       "homeTeam.teamTricode",
+	AwayCodePath:       "awayTeam.teamTricode",
+	HomeNamePath:       "homeTeam.teamName",
+	AwayNamePath:       "awayTeam.teamName",
+	HomeScorePath:      "homeTeam.score",
+	AwayScorePath:      "awayTeam.score",
+	StatusPath:         "gameStatus",
+	StatusEndedVal:     "3",
+	StatusScheduledVal: "1",
+	Headers: map[string]string{
+		"User-Agent": "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/116.0.0.0 Safari/537.36",
+		"Referer":    "https://www.nba.com/",
+		"Origin":     "https://www.nba.com",
+		"Accept":     "application/json",
+	},
+}
+
+// NFLAdapterConfig drives a SportsFeedAdapter off ESPN's public NFL
+// scoreboard and summary endpoints.
+var NFLAdapterConfig = AdapterConfig{
+	LeagueId:           models.LeagueIdNFL,
+	LeagueName:         "NFL",
+	ScheduleURL:        "https://site.api.espn.com/apis/site/v2/sports/football/nfl/scoreboard",
+	GamesPath:          "events",
+	GameIdPath:         "id",
+	BoxscoreURLFmt:     "https://site.api.espn.com/apis/site/v2/sports/football/nfl/summary?event=%s",
+	HomeCodePath:       "competitions.0.competitors.0.team.abbreviation",
+	AwayCodePath:       "competitions.0.competitors.1.team.abbreviation",
+	HomeNamePath:       "competitions.0.competitors.0.team.displayName",
+	AwayNamePath:       "competitions.0.competitors.1.team.displayName",
+	HomeScorePath:      "competitions.0.competitors.0.score",
+	AwayScorePath:      "competitions.0.competitors.1.score",
+	StatusPath:         "status.type.name",
+	StatusEndedVal:     "STATUS_FINAL",
+	StatusScheduledVal: "STATUS_SCHEDULED",
+}
+
+// SoccerAdapterConfig drives a SportsFeedAdapter off football-data.org's
+// public matches endpoint. football-data.org requires an API token on
+// every request, set via the FOOTBALL_DATA_API_TOKEN env var.
+var SoccerAdapterConfig = AdapterConfig{
+	LeagueId:           models.LeagueIdSoccer,
+	LeagueName:         "Soccer",
+	ScheduleURL:        "https://api.football-data.org/v4/matches",
+	GamesPath:          "matches",
+	GameIdPath:         "id",
+	BoxscoreURLFmt:     "https://api.football-data.org/v4/matches/%s",
+	HomeCodePath:       "homeTeam.tla",
+	AwayCodePath:       "awayTeam.tla",
+	HomeNamePath:       "homeTeam.name",
+	AwayNamePath:       "awayTeam.name",
+	HomeScorePath:      "score.fullTime.home",
+	AwayScorePath:      "score.fullTime.away",
+	StatusPath:         "status",
+	StatusEndedVal:     "FINISHED",
+	StatusScheduledVal: "SCHEDULED",
+	AuthHeader:         "X-Auth-Token",
+	AuthTokenEnv:       "FOOTBALL_DATA_API_TOKEN",
+}
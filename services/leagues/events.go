@@ -0,0 +1,29 @@
+package leagues
+
+import "goalfeed/models"
+
+// ScoreDeltaEvents compares update's old and new state and returns a goal
+// event for each side whose score increased. Every ILeagueService derives
+// its events this way, whether the state transition came from polling, a
+// live subscription, or a replayed play log.
+func ScoreDeltaEvents(update models.GameUpdate, leagueId int, leagueName string) []models.Event {
+	var events []models.Event
+
+	if update.NewState.Home.Score > update.OldState.Home.Score {
+		events = append(events, models.Event{
+			TeamCode:   update.NewState.Home.Team.TeamCode,
+			TeamName:   update.NewState.Home.Team.TeamName,
+			LeagueId:   leagueId,
+			LeagueName: leagueName,
+		})
+	}
+	if update.NewState.Away.Score > update.OldState.Away.Score {
+		events = append(events, models.Event{
+			TeamCode:   update.NewState.Away.Team.TeamCode,
+			TeamName:   update.NewState.Away.Team.TeamName,
+			LeagueId:   leagueId,
+			LeagueName: leagueName,
+		})
+	}
+	return events
+}
@@ -0,0 +1,68 @@
+package leagues
+
+import (
+	"goalfeed/models"
+	"testing"
+)
+
+func TestScoreDeltaEventsNoChange(t *testing.T) {
+	state := models.GameState{
+		Home: models.Side{Team: models.Team{TeamCode: "BOS"}, Score: 2},
+		Away: models.Side{Team: models.Team{TeamCode: "TOR"}, Score: 1},
+	}
+	update := models.GameUpdate{OldState: state, NewState: state}
+
+	if events := ScoreDeltaEvents(update, models.LeagueIdNHL, "NHL"); len(events) != 0 {
+		t.Errorf("ScoreDeltaEvents() = %v, want none", events)
+	}
+}
+
+func TestScoreDeltaEventsHomeGoal(t *testing.T) {
+	update := models.GameUpdate{
+		OldState: models.GameState{
+			Home: models.Side{Team: models.Team{TeamCode: "BOS"}, Score: 1},
+			Away: models.Side{Team: models.Team{TeamCode: "TOR"}, Score: 1},
+		},
+		NewState: models.GameState{
+			Home: models.Side{Team: models.Team{TeamCode: "BOS"}, Score: 2},
+			Away: models.Side{Team: models.Team{TeamCode: "TOR"}, Score: 1},
+		},
+	}
+
+	events := ScoreDeltaEvents(update, models.LeagueIdNHL, "NHL")
+	if len(events) != 1 {
+		t.Fatalf("ScoreDeltaEvents() returned %d events, want 1", len(events))
+	}
+	if events[0].TeamCode != "BOS" || events[0].LeagueName != "NHL" {
+		t.Errorf("ScoreDeltaEvents() = %+v, want BOS/NHL", events[0])
+	}
+}
+
+func TestScoreDeltaEventsBothGoals(t *testing.T) {
+	update := models.GameUpdate{
+		OldState: models.GameState{
+			Home: models.Side{Team: models.Team{TeamCode: "BOS"}, Score: 1},
+			Away: models.Side{Team: models.Team{TeamCode: "TOR"}, Score: 1},
+		},
+		NewState: models.GameState{
+			Home: models.Side{Team: models.Team{TeamCode: "BOS"}, Score: 2},
+			Away: models.Side{Team: models.Team{TeamCode: "TOR"}, Score: 2},
+		},
+	}
+
+	events := ScoreDeltaEvents(update, models.LeagueIdNHL, "NHL")
+	if len(events) != 2 {
+		t.Fatalf("ScoreDeltaEvents() returned %d events, want 2", len(events))
+	}
+}
+
+func TestScoreDeltaEventsIgnoresScoreDecrease(t *testing.T) {
+	update := models.GameUpdate{
+		OldState: models.GameState{Home: models.Side{Score: 3}},
+		NewState: models.GameState{Home: models.Side{Score: 2}},
+	}
+
+	if events := ScoreDeltaEvents(update, models.LeagueIdNHL, "NHL"); len(events) != 0 {
+		t.Errorf("ScoreDeltaEvents() = %v, want none for a score decrease", events)
+	}
+}
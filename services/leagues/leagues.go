@@ -0,0 +1,33 @@
+package leagues
+
+import (
+	"context"
+	"goalfeed/models"
+)
+
+// ILeagueService is implemented by each supported league's service and
+// is the boundary the main loop uses to fetch active games, poll updates
+// and derive goal events from them.
+type ILeagueService interface {
+	GetLeagueName() string
+
+	// GetActiveGames fetches the league's current schedule and writes the
+	// result, including whether the fetch itself succeeded, to
+	// resultChan. Callers should use Err to judge poll freshness rather
+	// than an empty Games, since a league can genuinely have no games in
+	// progress (e.g. offseason) without anything being wrong.
+	GetActiveGames(resultChan chan models.ActiveGamesResult)
+	GetGameUpdate(game models.Game, updateChan chan models.GameUpdate)
+	GetEvents(update models.GameUpdate, eventChan chan []models.Event)
+
+	// SubscribeGameUpdates streams updates for game for as long as ctx is
+	// live, reconnecting with backoff on transient feed errors. It returns
+	// an error immediately if the league has no push feed for this game,
+	// in which case callers should fall back to polling GetGameUpdate.
+	SubscribeGameUpdates(game models.Game, ctx context.Context) (<-chan models.GameUpdate, error)
+
+	// GetGameReplay fetches a completed game's full play log and returns its
+	// final state alongside every scoring event in the order they occurred,
+	// so they can be replayed through the same path live goals take.
+	GetGameReplay(gameId string) (models.Game, []models.Event, error)
+}
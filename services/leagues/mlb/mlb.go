@@ -0,0 +1,79 @@
+package mlb
+
+import (
+	"context"
+	"errors"
+	"goalfeed/models"
+	"goalfeed/services/leagues"
+)
+
+// ErrSubscribeUnsupported is returned by SubscribeGameUpdates: the MLB
+// stats API does not yet expose a push feed, so callers must fall back
+// to polling GetGameUpdate.
+var ErrSubscribeUnsupported = errors.New("mlb: live subscription not supported, use polling")
+
+// IApiClient is the subset of the MLB API client the service depends on.
+type IApiClient interface {
+	GetActiveGames() ([]models.Game, error)
+	GetGameState(gameId string) (models.GameState, error)
+	GetPlayByPlay(gameId string) ([]models.GameState, error)
+}
+
+// MLBService implements leagues.ILeagueService for MLB.
+type MLBService struct {
+	Client IApiClient
+}
+
+// GetLeagueName returns the display name for this league.
+func (s MLBService) GetLeagueName() string {
+	return "MLB"
+}
+
+// GetActiveGames fetches games currently in progress and writes them to resultChan.
+func (s MLBService) GetActiveGames(resultChan chan models.ActiveGamesResult) {
+	games, err := s.Client.GetActiveGames()
+	resultChan <- models.ActiveGamesResult{Games: games, Err: err}
+}
+
+// GetGameUpdate fetches the latest state for game and writes the update to updateChan.
+func (s MLBService) GetGameUpdate(game models.Game, updateChan chan models.GameUpdate) {
+	newState, err := s.Client.GetGameState(game.GameId)
+	if err != nil {
+		updateChan <- models.GameUpdate{OldState: game.CurrentState, NewState: game.CurrentState, Err: err}
+		return
+	}
+	updateChan <- models.GameUpdate{OldState: game.CurrentState, NewState: newState}
+}
+
+// GetEvents derives goal events (runs) from the score delta in update and writes them to eventChan.
+func (s MLBService) GetEvents(update models.GameUpdate, eventChan chan []models.Event) {
+	eventChan <- leagues.ScoreDeltaEvents(update, models.LeagueIdMLB, "MLB")
+}
+
+// SubscribeGameUpdates always returns ErrSubscribeUnsupported: MLB has no
+// live push feed yet, so callers should fall back to GetGameUpdate polling.
+func (s MLBService) SubscribeGameUpdates(game models.Game, ctx context.Context) (<-chan models.GameUpdate, error) {
+	return nil, ErrSubscribeUnsupported
+}
+
+// GetGameReplay fetches gameId's full play-by-play log and derives the
+// scoring events from it in order, reusing GetEvents so a replay produces
+// exactly the events a live poll of the same transitions would have.
+func (s MLBService) GetGameReplay(gameId string) (models.Game, []models.Event, error) {
+	states, err := s.Client.GetPlayByPlay(gameId)
+	if err != nil {
+		return models.Game{}, nil, err
+	}
+
+	game := models.Game{GameId: gameId, LeagueId: models.LeagueIdMLB}
+	var events []models.Event
+	prevState := models.GameState{}
+	for _, state := range states {
+		eventChan := make(chan []models.Event)
+		go s.GetEvents(models.GameUpdate{OldState: prevState, NewState: state}, eventChan)
+		events = append(events, <-eventChan...)
+		prevState = state
+	}
+	game.CurrentState = prevState
+	return game, events, nil
+}
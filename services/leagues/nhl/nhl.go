@@ -0,0 +1,132 @@
+package nhl
+
+import (
+	"context"
+	"goalfeed/models"
+	"goalfeed/services/leagues"
+	"time"
+)
+
+const subscribeMaxBackoff = 30 * time.Second
+
+// IApiClient is the subset of the NHL API client the service depends on.
+type IApiClient interface {
+	GetActiveGames() ([]models.Game, error)
+	GetGameState(gameId string) (models.GameState, error)
+	StreamGameState(ctx context.Context, gameId string) (<-chan models.GameState, error)
+	GetPlayByPlay(gameId string) ([]models.GameState, error)
+}
+
+// NHLService implements leagues.ILeagueService for the NHL.
+type NHLService struct {
+	Client IApiClient
+}
+
+// GetLeagueName returns the display name for this league.
+func (s NHLService) GetLeagueName() string {
+	return "NHL"
+}
+
+// GetActiveGames fetches games currently in progress and writes them to resultChan.
+func (s NHLService) GetActiveGames(resultChan chan models.ActiveGamesResult) {
+	games, err := s.Client.GetActiveGames()
+	resultChan <- models.ActiveGamesResult{Games: games, Err: err}
+}
+
+// GetGameUpdate fetches the latest state for game and writes the update to updateChan.
+func (s NHLService) GetGameUpdate(game models.Game, updateChan chan models.GameUpdate) {
+	newState, err := s.Client.GetGameState(game.GameId)
+	if err != nil {
+		updateChan <- models.GameUpdate{OldState: game.CurrentState, NewState: game.CurrentState, Err: err}
+		return
+	}
+	updateChan <- models.GameUpdate{OldState: game.CurrentState, NewState: newState}
+}
+
+// GetEvents derives goal events from the score delta in update and writes them to eventChan.
+func (s NHLService) GetEvents(update models.GameUpdate, eventChan chan []models.Event) {
+	eventChan <- leagues.ScoreDeltaEvents(update, models.LeagueIdNHL, "NHL")
+}
+
+// SubscribeGameUpdates streams live play-by-play updates for game, reconnecting
+// with exponential backoff if the feed drops while ctx is still live. The
+// returned channel is closed once ctx is cancelled.
+func (s NHLService) SubscribeGameUpdates(game models.Game, ctx context.Context) (<-chan models.GameUpdate, error) {
+	states, err := s.Client.StreamGameState(ctx, game.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan models.GameUpdate)
+	go func() {
+		defer close(updates)
+		oldState := game.CurrentState
+		backoff := time.Second
+
+		for {
+			select {
+			case newState, ok := <-states:
+				if !ok {
+					if ctx.Err() != nil {
+						return
+					}
+					// Keep retrying with backoff until a reconnect
+					// succeeds or ctx is cancelled; states is only
+					// replaced once a new stream is open.
+					for {
+						select {
+						case <-time.After(backoff):
+						case <-ctx.Done():
+							return
+						}
+						backoff *= 2
+						if backoff > subscribeMaxBackoff {
+							backoff = subscribeMaxBackoff
+						}
+						var reconnected <-chan models.GameState
+						reconnected, err = s.Client.StreamGameState(ctx, game.GameId)
+						if err != nil {
+							continue
+						}
+						states = reconnected
+						break
+					}
+					continue
+				}
+				backoff = time.Second
+				update := models.GameUpdate{OldState: oldState, NewState: newState}
+				oldState = newState
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return updates, nil
+}
+
+// GetGameReplay fetches gameId's full play-by-play log and derives the
+// scoring events from it in order, reusing GetEvents so a replay produces
+// exactly the events a live poll of the same transitions would have.
+func (s NHLService) GetGameReplay(gameId string) (models.Game, []models.Event, error) {
+	states, err := s.Client.GetPlayByPlay(gameId)
+	if err != nil {
+		return models.Game{}, nil, err
+	}
+
+	game := models.Game{GameId: gameId, LeagueId: models.LeagueIdNHL}
+	var events []models.Event
+	prevState := models.GameState{}
+	for _, state := range states {
+		eventChan := make(chan []models.Event)
+		go s.GetEvents(models.GameUpdate{OldState: prevState, NewState: state}, eventChan)
+		events = append(events, <-eventChan...)
+		prevState = state
+	}
+	game.CurrentState = prevState
+	return game, events, nil
+}
@@ -0,0 +1,31 @@
+package replay
+
+import (
+	"fmt"
+	"goalfeed/models"
+	"goalfeed/services/events"
+	"goalfeed/services/leagues"
+	"goalfeed/utils"
+)
+
+var logger = utils.GetLogger()
+
+// Game fetches gameId's full play-by-play log from service and replays every
+// scoring event through the same path a live goal takes. In dry-run mode
+// events are only logged, never delivered to pusher/database.
+func Game(service leagues.ILeagueService, gameId string, dryRun bool) error {
+	game, evts, err := service.GetGameReplay(gameId)
+	if err != nil {
+		return fmt.Errorf("replay %s game %s: %w", service.GetLeagueName(), gameId, err)
+	}
+
+	logger.Info(fmt.Sprintf("[%s - %s] Replaying %d goal(s)", service.GetLeagueName(), gameId, len(evts)))
+	for _, event := range evts {
+		if dryRun {
+			logger.Info(fmt.Sprintf("[dry-run][%s - %s] Goal %s", service.GetLeagueName(), gameId, event.TeamCode))
+			continue
+		}
+		events.FireSync([]models.Event{event}, game)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"goalfeed/config"
+	"goalfeed/models"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var db *gorm.DB
+
+// Goal is the persisted record of a single scoring event.
+type Goal struct {
+	gorm.Model
+	TeamCode string
+	TeamName string
+}
+
+// InitializeDatabase opens the configured database connection and runs migrations.
+func InitializeDatabase() {
+	conn, err := gorm.Open(postgres.Open(config.GetString("DATABASE_URL")), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+	db = conn
+	_ = db.AutoMigrate(&Goal{})
+}
+
+// InsertGoal records a goal scored by team.
+func InsertGoal(team models.Team) error {
+	return db.Create(&Goal{TeamCode: team.TeamCode, TeamName: team.TeamName}).Error
+}
+
+// Ping checks connectivity to the database, used by the readiness probe.
+func Ping() error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Ping()
+}
+
+// Sink is the targets.EventSink implementation that persists goals to Postgres.
+type Sink struct{}
+
+// Name identifies this sink for logging and the SINKS env var.
+func (Sink) Name() string {
+	return "postgres"
+}
+
+// Emit persists a Goal row for whichever team scored according to game's
+// current state.
+func (Sink) Emit(ctx context.Context, event models.Event, game models.Game) error {
+	var scoringTeam models.Team
+	if event.TeamCode == game.CurrentState.Home.Team.TeamCode {
+		scoringTeam = game.CurrentState.Home.Team
+	} else {
+		scoringTeam = game.CurrentState.Away.Team
+	}
+	return InsertGoal(scoringTeam)
+}
@@ -0,0 +1,63 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goalfeed/config"
+	"goalfeed/models"
+	"net/http"
+)
+
+// Sink posts a goal message to a Discord channel webhook.
+type Sink struct {
+	url    string
+	client *http.Client
+}
+
+// New builds a Discord Sink from the DISCORD_WEBHOOK_URL env var.
+func New() (*Sink, error) {
+	url := config.GetString("DISCORD_WEBHOOK_URL")
+	if url == "" {
+		return nil, errors.New("discord: DISCORD_WEBHOOK_URL is not set")
+	}
+	return &Sink{url: url, client: http.DefaultClient}, nil
+}
+
+// Name identifies this sink for logging and the SINKS env var.
+func (s *Sink) Name() string {
+	return "discord"
+}
+
+type message struct {
+	Content string `json:"content"`
+}
+
+// Emit posts a goal message to the configured Discord webhook.
+func (s *Sink) Emit(ctx context.Context, event models.Event, game models.Game) error {
+	body, err := json.Marshal(message{
+		Content: fmt.Sprintf("🚨 GOAL! %s (%s)", event.TeamName, event.LeagueName),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("discord: unexpected status %s", resp.Status)
+	}
+	return nil
+}
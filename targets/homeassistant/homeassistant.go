@@ -0,0 +1,71 @@
+package homeassistant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goalfeed/config"
+	"goalfeed/models"
+	"net/http"
+)
+
+// Sink calls a Home Assistant webhook to trigger an automation (e.g. a goal
+// horn on a smart speaker or light) whenever a goal is scored.
+type Sink struct {
+	baseURL string
+	webhook string
+	token   string
+	client  *http.Client
+}
+
+// New builds a Home Assistant Sink from HOME_ASSISTANT_URL,
+// HOME_ASSISTANT_WEBHOOK_ID and the optional HOME_ASSISTANT_TOKEN env vars.
+func New() (*Sink, error) {
+	baseURL := config.GetString("HOME_ASSISTANT_URL")
+	webhook := config.GetString("HOME_ASSISTANT_WEBHOOK_ID")
+	if baseURL == "" || webhook == "" {
+		return nil, errors.New("homeassistant: HOME_ASSISTANT_URL and HOME_ASSISTANT_WEBHOOK_ID are required")
+	}
+	return &Sink{
+		baseURL: baseURL,
+		webhook: webhook,
+		token:   config.GetString("HOME_ASSISTANT_TOKEN"),
+		client:  http.DefaultClient,
+	}, nil
+}
+
+// Name identifies this sink for logging and the SINKS env var.
+func (s *Sink) Name() string {
+	return "homeassistant"
+}
+
+// Emit POSTs event to the configured Home Assistant webhook.
+func (s *Sink) Emit(ctx context.Context, event models.Event, game models.Game) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/api/webhook/%s", s.baseURL, s.webhook)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("homeassistant: unexpected status %s", resp.Status)
+	}
+	return nil
+}
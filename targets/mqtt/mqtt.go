@@ -0,0 +1,55 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goalfeed/config"
+	"goalfeed/models"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+)
+
+// Sink publishes a JSON payload for every goal to an MQTT broker, under a
+// topic scoped by league and team code.
+type Sink struct {
+	client paho.Client
+}
+
+// New connects to the broker configured by MQTT_BROKER_URL and returns a
+// ready-to-use Sink.
+func New() (*Sink, error) {
+	broker := config.GetString("MQTT_BROKER_URL")
+	if broker == "" {
+		return nil, errors.New("mqtt: MQTT_BROKER_URL is not set")
+	}
+
+	opts := paho.NewClientOptions().
+		AddBroker(broker).
+		SetClientID("goalfeed")
+	client := paho.NewClient(opts)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		return nil, token.Error()
+	}
+
+	return &Sink{client: client}, nil
+}
+
+// Name identifies this sink for logging and the SINKS env var.
+func (s *Sink) Name() string {
+	return "mqtt"
+}
+
+// Emit publishes event to goalfeed/goals/<league>/<teamCode>.
+func (s *Sink) Emit(ctx context.Context, event models.Event, game models.Game) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	topic := fmt.Sprintf("goalfeed/goals/%s/%s", event.LeagueName, event.TeamCode)
+	token := s.client.Publish(topic, 0, false, payload)
+	token.Wait()
+	return token.Error()
+}
@@ -0,0 +1,36 @@
+package pusher
+
+import (
+	"context"
+	"goalfeed/config"
+	"goalfeed/models"
+
+	pusherClient "github.com/pusher/pusher-http-go/v5"
+)
+
+var client = pusherClient.Client{
+	AppID:   config.GetString("PUSHER_APP_ID"),
+	Key:     config.GetString("PUSHER_KEY"),
+	Secret:  config.GetString("PUSHER_SECRET"),
+	Cluster: config.GetString("PUSHER_CLUSTER"),
+	Secure:  true,
+}
+
+// SendEvent publishes event to the goals channel.
+func SendEvent(event models.Event) error {
+	return client.Trigger("goals", "goal", event)
+}
+
+// Sink is the targets.EventSink implementation that publishes goals over
+// Pusher channels.
+type Sink struct{}
+
+// Name identifies this sink for logging and the SINKS env var.
+func (Sink) Name() string {
+	return "pusher"
+}
+
+// Emit publishes event to the goals channel.
+func (Sink) Emit(ctx context.Context, event models.Event, game models.Game) error {
+	return SendEvent(event)
+}
@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"goalfeed/config"
+	"goalfeed/models"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+const activeGamesKey = "active_games"
+
+var ctx = context.Background()
+
+var client = goredis.NewClient(&goredis.Options{
+	Addr:     config.GetString("REDIS_ADDR"),
+	Password: config.GetString("REDIS_PASSWORD"),
+})
+
+// SetGame persists the current state of game, keyed by its game key.
+func SetGame(game models.Game) {
+	data, _ := json.Marshal(game)
+	client.Set(ctx, game.GetGameKey(), data, 0)
+}
+
+// GetGameByGameKey loads the persisted game for gameKey.
+func GetGameByGameKey(gameKey string) (models.Game, error) {
+	data, err := client.Get(ctx, gameKey).Bytes()
+	if err != nil {
+		return models.Game{}, err
+	}
+	var game models.Game
+	if err := json.Unmarshal(data, &game); err != nil {
+		return models.Game{}, err
+	}
+	return game, nil
+}
+
+// AppendActiveGame marks gameKey as actively monitored.
+func AppendActiveGame(game models.Game) {
+	client.SAdd(ctx, activeGamesKey, game.GetGameKey())
+}
+
+// GetActiveGameKeys returns the game keys currently being monitored.
+func GetActiveGameKeys() []string {
+	keys, _ := client.SMembers(ctx, activeGamesKey).Result()
+	return keys
+}
+
+// DeleteActiveGameKey stops monitoring the game identified by gameKey.
+func DeleteActiveGameKey(gameKey string) {
+	client.SRem(ctx, activeGamesKey, gameKey)
+}
+
+// DeleteActiveGame stops monitoring game.
+func DeleteActiveGame(game models.Game) {
+	DeleteActiveGameKey(game.GetGameKey())
+}
+
+// Ping checks connectivity to redis, used by the readiness probe.
+func Ping() error {
+	return client.Ping(ctx).Err()
+}
+
+// Client returns the shared redis connection, for callers (e.g. leader
+// election) that need lower-level commands than this package exposes.
+func Client() *goredis.Client {
+	return client
+}
@@ -0,0 +1,87 @@
+package targets
+
+import (
+	"context"
+	"fmt"
+	"goalfeed/metrics"
+	"goalfeed/models"
+	"goalfeed/utils"
+	"time"
+)
+
+// sinkBufferSize bounds how many pending events a single slow sink can
+// queue before new events for it are dropped, so it can't back up and
+// block delivery to the other sinks.
+const sinkBufferSize = 32
+
+var logger = utils.GetLogger()
+
+type sinkJob struct {
+	event models.Event
+	game  models.Game
+}
+
+// Registry fans a stream of goal events out to a set of sinks, each running
+// its own goroutine and buffered queue so one sink's latency or downtime
+// never delays the others.
+type Registry struct {
+	sinks  []EventSink
+	queues map[string]chan sinkJob
+}
+
+// NewRegistry starts a goroutine per sink and returns a Registry ready to
+// receive events. ctx cancellation stops all sink goroutines.
+func NewRegistry(ctx context.Context, sinks []EventSink) *Registry {
+	r := &Registry{sinks: sinks, queues: make(map[string]chan sinkJob, len(sinks))}
+	for _, sink := range sinks {
+		queue := make(chan sinkJob, sinkBufferSize)
+		r.queues[sink.Name()] = queue
+		go r.run(ctx, sink, queue)
+	}
+	return r
+}
+
+func (r *Registry) run(ctx context.Context, sink EventSink, queue chan sinkJob) {
+	for {
+		select {
+		case job := <-queue:
+			start := time.Now()
+			err := sink.Emit(ctx, job.event, job.game)
+			metrics.SinkLatency.WithLabelValues(sink.Name()).Observe(time.Since(start).Seconds())
+			if err != nil {
+				logger.Error(fmt.Sprintf("[%s] Failed to emit event: %s", sink.Name(), err.Error()))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Emit enqueues event for every registered sink. A sink whose queue is full
+// has the event dropped for it rather than blocking the caller.
+func (r *Registry) Emit(event models.Event, game models.Game) {
+	job := sinkJob{event: event, game: game}
+	for name, queue := range r.queues {
+		select {
+		case queue <- job:
+		default:
+			logger.Error(fmt.Sprintf("[%s] Sink queue full, dropping event", name))
+		}
+	}
+}
+
+// EmitSync delivers event to every registered sink directly, waiting for
+// each to finish before returning, instead of enqueueing onto the async
+// per-sink goroutines Emit uses. Short-lived callers like the replay CLI
+// need this: they exit right after the call and would otherwise race their
+// own process exit against delivery.
+func (r *Registry) EmitSync(ctx context.Context, event models.Event, game models.Game) {
+	for _, sink := range r.sinks {
+		start := time.Now()
+		err := sink.Emit(ctx, event, game)
+		metrics.SinkLatency.WithLabelValues(sink.Name()).Observe(time.Since(start).Seconds())
+		if err != nil {
+			logger.Error(fmt.Sprintf("[%s] Failed to emit event: %s", sink.Name(), err.Error()))
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package targets
+
+import (
+	"context"
+	"goalfeed/models"
+)
+
+// EventSink delivers a goal event to one external system, e.g. a chat
+// webhook, a database, or a smart-home integration. Emit is expected to be
+// best-effort and should respect ctx cancellation; goal detection must
+// never block on a slow or wedged sink.
+type EventSink interface {
+	Name() string
+	Emit(ctx context.Context, event models.Event, game models.Game) error
+}
@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goalfeed/config"
+	"goalfeed/models"
+	"net/http"
+)
+
+// Sink POSTs a JSON payload to a generic configured URL for every goal.
+type Sink struct {
+	url    string
+	client *http.Client
+}
+
+// New builds a webhook Sink from the WEBHOOK_URL env var.
+func New() (*Sink, error) {
+	url := config.GetString("WEBHOOK_URL")
+	if url == "" {
+		return nil, errors.New("webhook: WEBHOOK_URL is not set")
+	}
+	return &Sink{url: url, client: http.DefaultClient}, nil
+}
+
+// Name identifies this sink for logging and the SINKS env var.
+func (s *Sink) Name() string {
+	return "webhook"
+}
+
+type payload struct {
+	Event models.Event `json:"event"`
+	Game  models.Game  `json:"game"`
+}
+
+// Emit POSTs event and game as JSON to the configured URL.
+func (s *Sink) Emit(ctx context.Context, event models.Event, game models.Game) error {
+	body, err := json.Marshal(payload{Event: event, Game: game})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook: unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+)
+
+var logger = newLogger()
+
+func newLogger() *logrus.Logger {
+	l := logrus.New()
+	l.Out = os.Stdout
+	l.SetFormatter(&logrus.TextFormatter{FullTimestamp: true})
+	return l
+}
+
+// GetLogger returns the shared application logger.
+func GetLogger() *logrus.Logger {
+	return logger
+}